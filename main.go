@@ -1,6 +1,8 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 	"os/signal"
 	"time"
@@ -13,33 +15,37 @@ func main() {
 	// which will immediately exit gitleaks
 	stopChan := make(chan os.Signal, 1)
 	signal.Notify(stopChan, os.Interrupt)
-	go listenForInterrupt(stopChan)
+
+	// runStart is used for the stderr summary, which covers the whole run
+	// rather than just the scan
+	runStart := time.Now()
+	go listenForInterrupt(stopChan, runStart)
 
 	// setup options
 	opts, err := options.ParseOptions()
 	if err != nil {
 		log.Error(err)
-		os.Exit(1)
+		exitWith(0, 1, runStart)
 	}
 
 	err = opts.Guard()
 	if err != nil {
 		log.Error(err)
-		os.Exit(1)
+		exitWith(0, 1, runStart)
 	}
 
 	// setup configs
 	cfg, err := config.NewConfig(opts)
 	if err != nil {
 		log.Error(err)
-		os.Exit(1)
+		exitWith(0, 1, runStart)
 	}
 
 	// setup scanner
 	scanner, err := scan.NewScanner(opts, cfg)
 	if err != nil {
 		log.Error(err)
-		os.Exit(1)
+		exitWith(0, 1, runStart)
 	}
 
 	// run and time the scan
@@ -48,18 +54,48 @@ func main() {
 	log.Info("scan time: ", durafmt.Parse(time.Now().Sub(start)))
 	if err != nil {
 		log.Error(err)
-		os.Exit(1)
+		exitWith(0, 1, runStart)
 	}
 
 	// report scan
 	if err := scan.WriteReport(scannerReport, opts, cfg); err != nil {
 		log.Error(err)
-		os.Exit(1)
+		exitWith(len(scannerReport.Leaks), 1, runStart)
+	}
+	exitWith(len(scannerReport.Leaks), 0, runStart)
+}
+
+// summary is the one-line JSON written to stderr at the end of every run,
+// independent of the report format, so wrappers can act on it without
+// parsing the report itself.
+type summary struct {
+	Leaks    int    `json:"leaks"`
+	Errors   int    `json:"errors"` // 1 if the run aborted, else 0
+	Duration string `json:"duration"`
+}
+
+// exitWith writes the stderr summary and exits with code. Every exit from
+// main goes through here so the summary line is never skipped.
+func exitWith(leaks, code int, start time.Time) {
+	writeSummary(leaks, code, start)
+	os.Exit(code)
+}
+
+func writeSummary(leaks, errors int, start time.Time) {
+	b, err := json.Marshal(summary{
+		Leaks:    leaks,
+		Errors:   errors,
+		Duration: time.Since(start).String(),
+	})
+	if err != nil {
+		log.Error(err)
+		return
 	}
+	fmt.Fprintln(os.Stderr, string(b))
 }
 
-func listenForInterrupt(stopScan chan os.Signal) {
+func listenForInterrupt(stopScan chan os.Signal, start time.Time) {
 	<-stopScan
 	log.Warn("halting gitleaks scan")
-	os.Exit(1)
+	exitWith(0, 1, start)
 }